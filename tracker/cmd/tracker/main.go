@@ -18,26 +18,79 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
+	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
-	"path/filepath"
+	"path"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 	"unicode/utf8"
 
 	"github.com/Itz-Agasta/nerrf/tracker/pkg/bpf"
+	"github.com/Itz-Agasta/nerrf/tracker/pkg/journal"
+	"github.com/Itz-Agasta/nerrf/tracker/pkg/metrics"
 	pb "github.com/Itz-Agasta/nerrf/tracker/pkg/pb"
+	"github.com/cilium/ebpf"
 	"github.com/cilium/ebpf/ringbuf"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// runCompaction periodically drops journal segments older than retention.
+// Runs for the lifetime of the process; call via `go runCompaction(...)`.
+func runCompaction(jrnl *journal.Journal, retention time.Duration) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := jrnl.Compact(retention); err != nil {
+			log.Printf("journal compaction error: %v", err)
+		}
+	}
+}
+
+// pollDroppedEvents periodically sums the per-CPU dropped_events map
+// (incremented by tracepoints.c's reserve_event on a full ring buffer) and
+// adds newly-seen drops to both the tracker_ringbuf_lost_total counter and
+// s.lostSamples, a monotonically increasing total that broadcastEvents
+// diffs against to credit EventBatch.lost_samples on journaled records and
+// client batches (see broadcastEvents). Runs for the lifetime of the
+// process.
+func (s *server) pollDroppedEvents(droppedEvents *ebpf.Map) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	var lastTotal uint64
+	for range ticker.C {
+		var perCPU []uint64
+		if err := droppedEvents.Lookup(uint32(0), &perCPU); err != nil {
+			log.Printf("dropped_events lookup error: %v", err)
+			continue
+		}
+		var total uint64
+		for _, c := range perCPU {
+			total += c
+		}
+		if total <= lastTotal {
+			continue
+		}
+		delta := total - lastTotal
+		lastTotal = total
+		metrics.RingbufLost.Add(float64(delta))
+		atomic.AddUint64(&s.lostSamples, delta)
+	}
+}
+
 // getenvDefault returns the value of environment variable k, or v if not set.
 // Used for configurable runtime parameters like listening address.
 func getenvDefault(k, v string) string {
@@ -50,36 +103,25 @@ func getenvDefault(k, v string) string {
 // main initializes and runs the NERRF Tracker service.
 //
 // Startup sequence:
-//  1. Locate and validate eBPF object file (tracepoints.o)
-//  2. Set memory limits for eBPF programs
-//  3. Load and attach eBPF tracepoints to kernel
-//  4. Initialize ring buffer reader for kernel events
-//  5. Start gRPC server for client connections
-//  6. Begin event processing and broadcasting
-//  7. Wait for shutdown signal and cleanup gracefully
+//  1. Set memory limits for eBPF programs
+//  2. Load the embedded eBPF objects and attach tracepoints to the kernel
+//  3. Initialize ring buffer reader for kernel events
+//  4. Start gRPC server for client connections
+//  5. Begin event processing and broadcasting
+//  6. Wait for shutdown signal and cleanup gracefully
 //
 // Environment Variables:
 //
-//	TRACKER_LISTEN_ADDR - gRPC server address (default: 127.0.0.1:50051)
+//	TRACKER_LISTEN_ADDR      - gRPC server address (default: 127.0.0.1:50051)
+//	TRACKER_WRITE_SAMPLE_RATE - fraction of writes (0.0-1.0) sampled for
+//	  entropy scoring (default: 1.0, i.e. every write)
+//	TRACKER_METRICS_ADDR     - Prometheus /metrics HTTP address (default: 127.0.0.1:9090)
 //
 // Requirements:
 //   - Root privileges for eBPF operations
 //   - Kernel 4.18+ with eBPF support
 //   - CAP_SYS_ADMIN capability
 func main() {
-	// Get the directory of the executable (I was having some import issue prv.)
-	execPath, err := os.Executable()
-	if err != nil {
-		log.Fatalf("get executable path: %v", err)
-	}
-	execDir := filepath.Dir(execPath)
-	objPath := filepath.Join(execDir, "../bpf/tracepoints.o")
-
-	// Validate BPF object exists
-	if _, err := os.Stat(objPath); os.IsNotExist(err) {
-		log.Fatalf("BPF object not found: %s", objPath)
-	}
-
 	// Set rlimit for eBPF - required for loading BPF programs
 	// RLIM_INFINITY allows unlimited memory locking for BPF maps
 	var rLimit unix.Rlimit
@@ -89,11 +131,23 @@ func main() {
 		log.Fatalf("setrlimit: %v", err)
 	}
 
-	// Load BPF object and attach tracepoints
-	// This attaches our programs to sys_enter_openat, sys_enter_write, sys_enter_rename
-	ringBufMap, links, err := bpf.LoadTracepoints(objPath)
+	sampleRate, err := strconv.ParseFloat(getenvDefault("TRACKER_WRITE_SAMPLE_RATE", "1.0"), 64)
+	if err != nil || sampleRate < 0 || sampleRate > 1 {
+		log.Fatalf("write sample rate: must be a float in [0, 1], got %q", getenvDefault("TRACKER_WRITE_SAMPLE_RATE", "1.0"))
+	}
+
+	// Load the embedded eBPF objects (compiled in at build time by bpf2go,
+	// see pkg/bpf) and attach them to sys_enter_openat, sys_exit_openat,
+	// sys_enter_write and sys_enter_rename.
+	objs, err := bpf.Load(bpf.Config{WriteSampleRateBps: uint32(sampleRate * 10000)})
+	if err != nil {
+		log.Fatalf("load bpf objects: %v", err)
+	}
+	defer objs.Close()
+
+	links, err := bpf.Attach(objs)
 	if err != nil {
-		log.Fatalf("load tracepoints: %v", err)
+		log.Fatalf("attach tracepoints: %v", err)
 	}
 	defer func() {
 		for _, l := range links {
@@ -103,12 +157,46 @@ func main() {
 
 	// Ring-buffer reader - reads events from kernel space
 	// Ring buffers are more efficient than older perf events
-	rd, err := ringbuf.NewReader(ringBufMap)
+	rd, err := ringbuf.NewReader(objs.Events)
 	if err != nil {
 		log.Fatalf("ringbuf: %v", err)
 	}
 	defer rd.Close()
 
+	// Write-ahead journal - every broadcast batch is appended here before
+	// fan-out, so a restarted tracker (or a client that connects late with
+	// a saved checkpoint) can recover history instead of only ever seeing
+	// whatever happens to stream live.
+	fsyncPolicy, err := journal.ParseFsyncPolicy(getenvDefault("TRACKER_JOURNAL_FSYNC", "always"))
+	if err != nil {
+		log.Fatalf("journal fsync policy: %v", err)
+	}
+	jrnl, err := journal.Open(
+		getenvDefault("TRACKER_JOURNAL_DIR", "/var/lib/nerrf/journal"),
+		journal.WithFsyncPolicy(fsyncPolicy, time.Second),
+	)
+	if err != nil {
+		log.Fatalf("open journal: %v", err)
+	}
+	defer jrnl.Close()
+
+	retention, err := time.ParseDuration(getenvDefault("TRACKER_JOURNAL_RETENTION", "168h"))
+	if err != nil {
+		log.Fatalf("journal retention: %v", err)
+	}
+	go runCompaction(jrnl, retention)
+
+	// Prometheus metrics, scraped from a separate HTTP endpoint so a
+	// metrics collector doesn't need gRPC support.
+	metricsAddr := getenvDefault("TRACKER_METRICS_ADDR", "127.0.0.1:9090")
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", promhttp.Handler())
+	go func() {
+		if err := http.ListenAndServe(metricsAddr, metricsMux); err != nil {
+			log.Printf("metrics server error: %v", err)
+		}
+	}()
+
 	// gRPC server setup
 	addr := getenvDefault("TRACKER_LISTEN_ADDR", "127.0.0.1:50051")
 	lis, err := net.Listen("tcp", addr)
@@ -117,9 +205,15 @@ func main() {
 	}
 	s := grpc.NewServer()
 	serv := &server{
-		rd:      rd,
-		clients: make(map[chan *pb.EventBatch]struct{}),
+		rd:       rd,
+		stacks:   objs.StackTraces,
+		resolver: bpf.NewStackResolver(),
+		journal:  jrnl,
+		clients:  make(map[chan *pb.EventBatch]*clientReg),
+		suspects: make(map[suspicionKey]*suspicionState),
 	}
+	go serv.pollDroppedEvents(objs.DroppedEvents)
+	go serv.pruneSuspects(time.Minute)
 
 	// Calculate boot time for accurate event timestamps
 	// eBPF uses CLOCK_MONOTONIC, we need to convert to wall clock time
@@ -155,55 +249,523 @@ func main() {
 	s.GracefulStop()
 }
 
+// Defaults applied to a client's BatchingPolicy when it leaves a field unset
+// (zero value), so every subscriber gets sane batching without having to
+// spell it out.
+const (
+	defaultMaxBatchSize     = 32
+	defaultMaxFlushInterval = 250 * time.Millisecond
+)
+
 // server implements the TrackerServer gRPC interface.
 // It manages client connections and broadcasts eBPF events to all connected clients.
 //
 // The server maintains:
 //   - rd: Ring buffer reader for kernel events
-//   - clients: Map of active client channels
+//   - clients: Map of active client registrations, keyed by their channel
 //   - bootTime: System boot time for timestamp conversion
+//   - stacks: Kernel stack_traces map, looked up by the stack_id on
+//     write/rename events
+//   - resolver: Symbolizes raw stack addresses against each process's
+//     memory map, lazily and only for events worth the cost
+//   - journal: Write-ahead log every broadcast batch is appended to,
+//     enabling Replay/Checkpoint and StreamEvents.resume_from
 //   - mu: Mutex for thread-safe client management
+//   - suspects/suspectsMu: Per-(pid, inode) ransomware suspicion state, see
+//     scoreSuspicion and pruneSuspects
+//   - nextClientID: Monotonic counter handing out each client's metrics
+//     label (see newClientReg)
+//   - lostSamples: Cumulative kernel ring-buffer drops observed so far (see
+//     pollDroppedEvents), monotonically increasing for the life of the
+//     process. broadcastEvents diffs it against lastLostSamples and each
+//     clientReg's own baseline rather than swapping-and-clearing it, so a
+//     drop isn't silently discarded on an iteration where nothing flushes
+//     (see broadcastEvents)
+//   - lastLostSamples: lostSamples as of the previous broadcastEvents
+//     iteration, touched only from that single goroutine
 type server struct {
 	pb.UnimplementedTrackerServer
-	rd       *ringbuf.Reader
-	mu       sync.Mutex
-	clients  map[chan *pb.EventBatch]struct{}
-	bootTime time.Time
+	rd              *ringbuf.Reader
+	stacks          *ebpf.Map
+	resolver        *bpf.StackResolver
+	journal         *journal.Journal
+	mu              sync.Mutex
+	clients         map[chan *pb.EventBatch]*clientReg
+	bootTime        time.Time
+	suspectsMu      sync.Mutex
+	suspects        map[suspicionKey]*suspicionState
+	nextClientID    uint64
+	lostSamples     uint64
+	lastLostSamples uint64
+}
+
+// openat() flags relevant to suspicion scoring. Only the bits scoreSuspicion
+// inspects are named here; the full set is carried through on Event.Flags.
+const (
+	oWRONLY = 0x1
+	oTRUNC  = 0x200
+)
+
+// entropyEWMAAlpha weights how quickly a file's rolling entropy average
+// reacts to each new write: high enough that a sustained encryptor loop
+// pushes the average up within a handful of writes, low enough that one
+// high-entropy write (e.g. a compressed asset) doesn't spike it.
+const entropyEWMAAlpha = 0.3
+
+// highEntropyThreshold is the Shannon entropy (bits per nibble, max 4.0)
+// above which a write is considered "encryption-like". Plaintext and most
+// structured formats sit well below this; AES/ChaCha output and compressed
+// data sit at or above it.
+const highEntropyThreshold = 3.5
+
+// suspicionKey identifies one file being written by one process, the unit
+// scoreSuspicion tracks state for.
+type suspicionKey struct {
+	pid   uint32
+	inode uint64
+}
+
+// suspicionState is the rolling signal kept per suspicionKey: whether the
+// file was opened with O_WRONLY|O_TRUNC (the overwrite-in-place pattern
+// LockBit-style encryptors use) and an EWMA of its write entropy.
+type suspicionState struct {
+	truncatingOpen bool
+	entropyEWMA    float64
+	lastSeen       time.Time
+}
+
+// suspectIdleTimeout is how long a (pid, inode) key's suspicion state is
+// kept without a new write before pruneSuspects evicts it. Unlike
+// tracepoints.c's fd_inodes/active_opens (which can only be trimmed on a
+// traced close(2), not yet implemented), s.suspects is plain Go and easy
+// to bound with a periodic sweep instead of leaking for the life of the
+// process.
+const suspectIdleTimeout = 10 * time.Minute
+
+// scoreSuspicion updates the rolling state for (pid, inode) with one write
+// event and returns a score in [0, 1]: 0 unless the file was opened
+// truncating, rising smoothly with entropyEWMA once it is, and only
+// meaningful once entropyEWMA has climbed above highEntropyThreshold.
+// flags is the openat() flags captured for this file (0 if the open
+// predates this process or was missed), and is only consulted the first
+// time a key is seen so a later plain write doesn't clear the flag.
+// sampled reports whether entropyQ8 came from an actually-sampled write
+// (TRACKER_WRITE_SAMPLE_RATE < 1.0 skips most writes, which arrive with
+// entropyQ8 == 0); unsampled writes update lastSeen but must not fold a
+// false "zero entropy" reading into entropyEWMA, or the EWMA gets dragged
+// back down between the rare sampled writes in exactly the high-volume
+// encryptor loop the sampling rate exists to stay cheap for.
+func (s *server) scoreSuspicion(key suspicionKey, openFlags uint32, entropyQ8 uint16, sampled bool) float64 {
+	s.suspectsMu.Lock()
+	defer s.suspectsMu.Unlock()
+
+	st, ok := s.suspects[key]
+	if !ok {
+		st = &suspicionState{}
+		s.suspects[key] = st
+	}
+	st.lastSeen = time.Now()
+	if openFlags&oWRONLY != 0 && openFlags&oTRUNC != 0 {
+		st.truncatingOpen = true
+	}
+
+	if sampled {
+		entropy := float64(entropyQ8) / 256
+		st.entropyEWMA = entropyEWMAAlpha*entropy + (1-entropyEWMAAlpha)*st.entropyEWMA
+	}
+
+	if !st.truncatingOpen || st.entropyEWMA < highEntropyThreshold {
+		return 0
+	}
+	score := (st.entropyEWMA - highEntropyThreshold) / (4.0 - highEntropyThreshold)
+	if score > 1 {
+		score = 1
+	}
+	return score
+}
+
+// pruneSuspects periodically evicts suspicion state for (pid, inode) keys
+// that haven't had a write in suspectIdleTimeout, so s.suspects doesn't
+// grow for the life of the process. Runs for the lifetime of the process;
+// call via `go serv.pruneSuspects(...)`.
+func (s *server) pruneSuspects(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		cutoff := time.Now().Add(-suspectIdleTimeout)
+		s.suspectsMu.Lock()
+		for key, st := range s.suspects {
+			if st.lastSeen.Before(cutoff) {
+				delete(s.suspects, key)
+			}
+		}
+		s.suspectsMu.Unlock()
+	}
+}
+
+// maxStackDepth mirrors PERF_MAX_STACK_DEPTH, the frame count the kernel
+// reserves per entry in the stack_traces map (see tracepoints.c).
+const maxStackDepth = 127
+
+// atFDCWD mirrors the AT_FDCWD constant used by openat(2) and friends to
+// mean "resolve relative to the current working directory".
+const atFDCWD = -100
+
+// resolveAbsolutePath turns a path captured by eBPF (which may be relative
+// to the calling process's cwd or to a directory fd) into an absolute
+// path, so downstream consumers can key events to files without also
+// tracking every process's working directory themselves.
+//
+// dfd is AT_FDCWD for syscalls with no directory-fd argument (rename,
+// openat's AT_FDCWD case) and a real fd otherwise. Resolution best-effort:
+// if /proc/<pid> has already gone away (the process exited) the original,
+// possibly-relative path is returned unchanged.
+func resolveAbsolutePath(pid uint32, dfd int64, p string) string {
+	if p == "" || path.IsAbs(p) {
+		return p
+	}
+
+	var link string
+	if dfd == atFDCWD {
+		link = fmt.Sprintf("/proc/%d/cwd", pid)
+	} else {
+		link = fmt.Sprintf("/proc/%d/fd/%d", pid, dfd)
+	}
+
+	base, err := os.Readlink(link)
+	if err != nil {
+		return p
+	}
+	return path.Join(base, p)
+}
+
+// resolveStack looks up the raw addresses for stackID in the kernel
+// stack_traces map and symbolizes them against pid's current address
+// space. Returns nil if there's no stack to resolve or resolution fails,
+// so callers can assign it to Event.Stack unconditionally.
+func resolveStack(stacks *ebpf.Map, resolver *bpf.StackResolver, pid uint32, stackID int64) []*pb.StackFrame {
+	if stackID < 0 {
+		return nil
+	}
+	var raw [maxStackDepth]uint64
+	if err := stacks.Lookup(uint32(stackID), &raw); err != nil {
+		return nil
+	}
+	frames, err := resolver.Resolve(pid, raw[:])
+	if err != nil {
+		return nil
+	}
+	pbFrames := make([]*pb.StackFrame, 0, len(frames))
+	for _, f := range frames {
+		pbFrames = append(pbFrames, &pb.StackFrame{
+			Addr:   f.Addr,
+			Module: f.Module,
+			Symbol: f.Symbol,
+			Offset: f.Offset,
+		})
+	}
+	return pbFrames
+}
+
+// clientReg is everything broadcastEvents needs to know about one
+// subscriber: its compiled filter, its batching policy, the batch it is
+// currently accumulating, and how it wants overflow handled.
+type clientReg struct {
+	id               string // metrics label, see newClientReg
+	ch               chan *pb.EventBatch
+	filter           compiledFilter
+	maxBatchSize     int
+	maxFlushInterval time.Duration
+	pending          []*pb.Event
+	lastFlush        time.Time
+
+	// lostSamples accumulates ring-buffer drops (see server.lostSamples)
+	// since this client's pending batch last flushed, so a drop observed
+	// on an iteration that doesn't happen to flush isn't lost - it carries
+	// forward to whichever iteration actually delivers a batch. Reset to
+	// 0 each time a batch is flushed (see broadcastEvents).
+	lostSamples uint64
+
+	// mode and blockDeadline govern enqueueBatch's behavior once ch is
+	// full; disconnect is closed (by enqueueBatch, never by StreamEvents)
+	// when mode is DISCONNECT_ON_OVERFLOW and overflow actually happens,
+	// so StreamEvents can end the stream instead of silently lagging.
+	mode           pb.DeliveryMode
+	blockDeadline  time.Duration
+	disconnect     chan struct{}
+	disconnectOnce sync.Once
+}
+
+// compiledFilter is a pb.Filter translated into a fast predicate so
+// broadcastEvents doesn't re-parse the glob/prefix on every event.
+type compiledFilter struct {
+	syscalls      map[pb.Syscall]struct{} // nil/empty means allow all
+	commPrefix    string
+	pid           uint32
+	hasPID        bool
+	uid           uint32
+	hasUID        bool
+	gid           uint32
+	hasGID        bool
+	pathGlob      string
+	minWriteBytes uint64
+}
+
+// compileFilter translates a client's requested pb.Filter into a
+// compiledFilter. A nil Filter matches every event.
+func compileFilter(f *pb.Filter) compiledFilter {
+	if f == nil {
+		return compiledFilter{}
+	}
+	cf := compiledFilter{
+		commPrefix:    f.GetCommPrefix(),
+		pid:           f.GetPid(),
+		hasPID:        f.GetPid() != 0,
+		uid:           f.GetUid(),
+		hasUID:        f.GetUseUid(),
+		gid:           f.GetGid(),
+		hasGID:        f.GetUseGid(),
+		pathGlob:      f.GetPathGlob(),
+		minWriteBytes: f.GetMinWriteBytes(),
+	}
+	if syscalls := f.GetSyscalls(); len(syscalls) > 0 {
+		cf.syscalls = make(map[pb.Syscall]struct{}, len(syscalls))
+		for _, sc := range syscalls {
+			cf.syscalls[sc] = struct{}{}
+		}
+	}
+	return cf
+}
+
+// matches reports whether e passes every predicate in cf. uid/gid are taken
+// from the event's already-resolved fields.
+func (cf compiledFilter) matches(e *pb.Event, sc pb.Syscall, uid, gid uint32) bool {
+	if len(cf.syscalls) > 0 {
+		if _, ok := cf.syscalls[sc]; !ok {
+			return false
+		}
+	}
+	if cf.commPrefix != "" && !strings.HasPrefix(e.GetComm(), cf.commPrefix) {
+		return false
+	}
+	if cf.hasPID && e.GetPid() != cf.pid {
+		return false
+	}
+	if cf.hasUID && uid != cf.uid {
+		return false
+	}
+	if cf.hasGID && gid != cf.gid {
+		return false
+	}
+	if cf.pathGlob != "" {
+		if ok, err := path.Match(cf.pathGlob, e.GetPath()); err != nil || !ok {
+			return false
+		}
+	}
+	if sc == pb.Syscall_SYSCALL_WRITE && e.GetBytes() < cf.minWriteBytes {
+		return false
+	}
+	return true
+}
+
+// newClientReg builds a clientReg from a client's StreamRequest, applying
+// default batching parameters where the client left them unset, and
+// assigns it a stable id for metrics labels.
+func newClientReg(req *pb.StreamRequest, id uint64) *clientReg {
+	batching := req.GetBatching()
+	maxBatchSize := int(batching.GetMaxBatchSize())
+	if maxBatchSize <= 0 {
+		maxBatchSize = defaultMaxBatchSize
+	}
+	maxFlushInterval := time.Duration(batching.GetMaxFlushIntervalMs()) * time.Millisecond
+	if maxFlushInterval <= 0 {
+		maxFlushInterval = defaultMaxFlushInterval
+	}
+	return &clientReg{
+		id:               strconv.FormatUint(id, 10),
+		ch:               make(chan *pb.EventBatch, 100),
+		filter:           compileFilter(req.GetFilter()),
+		maxBatchSize:     maxBatchSize,
+		maxFlushInterval: maxFlushInterval,
+		lastFlush:        time.Now(),
+		mode:             req.GetDeliveryMode(),
+		blockDeadline:    maxFlushInterval,
+		disconnect:       make(chan struct{}),
+	}
 }
 
 // StreamEvents implements the gRPC streaming endpoint for event distribution.
-// Each client gets their own channel and receives all events in real-time.
+// Each client supplies a StreamRequest selecting the syscalls/processes/paths
+// it cares about and how it wants events batched; broadcastEvents evaluates
+// the filter and accumulates matching events on the client's behalf.
 //
 // The method:
-//  1. Creates a buffered channel for this client
-//  2. Registers the channel in the clients map
-//  3. Streams events until client disconnects
-//  4. Cleans up client channel on completion
-//
-// Channel buffer size (100) prevents blocking on slow clients.
-func (s *server) StreamEvents(req *pb.Empty, stream pb.Tracker_StreamEventsServer) error {
-	clientChan := make(chan *pb.EventBatch, 100)
+//  1. Compiles the request into a clientReg and registers it, so any event
+//     that arrives during step 2 is queued rather than missed
+//  2. If resume_from is set, replays journaled events from that checkpoint
+//  3. Streams live batches until the client disconnects
+//  4. Cleans up the client registration on completion
+func (s *server) StreamEvents(req *pb.StreamRequest, stream pb.Tracker_StreamEventsServer) error {
+	reg := newClientReg(req, atomic.AddUint64(&s.nextClientID, 1))
 	s.mu.Lock()
-	s.clients[clientChan] = struct{}{}
+	s.clients[reg.ch] = reg
 	s.mu.Unlock()
 	defer func() {
 		s.mu.Lock()
-		delete(s.clients, clientChan)
+		delete(s.clients, reg.ch)
 		s.mu.Unlock()
-		close(clientChan)
+		close(reg.ch)
 	}()
+
+	if resumeFrom := req.GetResumeFrom(); resumeFrom != nil {
+		cp := journal.Checkpoint{SegmentID: resumeFrom.GetSegmentId(), Offset: resumeFrom.GetOffset()}
+		err := s.journal.ReplayFrom(cp, func(batch *pb.EventBatch) error {
+			if filtered := filterBatch(reg.filter, batch); filtered != nil {
+				return stream.Send(filtered)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("replay from checkpoint: %w", err)
+		}
+	}
+
 	for {
 		select {
-		case batch := <-clientChan:
+		case batch := <-reg.ch:
 			if err := stream.Send(batch); err != nil {
 				return err
 			}
+		case <-reg.disconnect:
+			return fmt.Errorf("client %s disconnected: delivery queue overflowed under DISCONNECT_ON_OVERFLOW", reg.id)
 		case <-stream.Context().Done():
 			return stream.Context().Err()
 		}
 	}
 }
 
+// Replay implements the Replay RPC: it streams journaled events in
+// [from_ts, to_ts] matching the request's filter, for clients that need
+// history rather than (or in addition to) the live stream.
+func (s *server) Replay(req *pb.ReplayRequest, stream pb.Tracker_ReplayServer) error {
+	var from, to time.Time
+	if ts := req.GetFromTs(); ts != nil {
+		from = ts.AsTime()
+	}
+	if ts := req.GetToTs(); ts != nil {
+		to = ts.AsTime()
+	}
+	cf := compileFilter(req.GetFilter())
+
+	return s.journal.Replay(from, to, func(e *pb.Event) bool {
+		return cf.matches(e, syscallEnumFromName(e.GetSyscall()), e.GetUid(), e.GetGid())
+	}, func(batch *pb.EventBatch) error {
+		return stream.Send(batch)
+	})
+}
+
+// Checkpoint implements the Checkpoint RPC: it returns the journal's
+// current write position, for a client to save and later pass back as
+// StreamRequest.resume_from.
+func (s *server) Checkpoint(ctx context.Context, req *pb.CheckpointRequest) (*pb.CheckpointResponse, error) {
+	cp := s.journal.Checkpoint()
+	return &pb.CheckpointResponse{
+		Checkpoint: &pb.JournalCheckpoint{SegmentId: cp.SegmentID, Offset: cp.Offset},
+	}, nil
+}
+
+// filterBatch returns a copy of batch containing only the events cf
+// matches, or nil if none match.
+func filterBatch(cf compiledFilter, batch *pb.EventBatch) *pb.EventBatch {
+	matched := make([]*pb.Event, 0, len(batch.GetEvents()))
+	for _, e := range batch.GetEvents() {
+		if cf.matches(e, syscallEnumFromName(e.GetSyscall()), e.GetUid(), e.GetGid()) {
+			matched = append(matched, e)
+		}
+	}
+	if len(matched) == 0 {
+		return nil
+	}
+	return &pb.EventBatch{Events: matched, LostSamples: batch.GetLostSamples()}
+}
+
+// syscallEnumFromName is the inverse of syscallName, used when
+// re-filtering a journaled pb.Event (which only carries the syscall's
+// string name) by syscall.
+func syscallEnumFromName(name string) pb.Syscall {
+	switch name {
+	case "openat":
+		return pb.Syscall_SYSCALL_OPENAT
+	case "write":
+		return pb.Syscall_SYSCALL_WRITE
+	case "rename":
+		return pb.Syscall_SYSCALL_RENAME
+	default:
+		return pb.Syscall_SYSCALL_UNKNOWN
+	}
+}
+
+// delivery pairs a client registration with the batch broadcastEvents
+// decided to flush to it, so the fan-out (which can block or drop,
+// depending on the client's DeliveryMode) happens after s.mu is released
+// instead of stalling every other client behind one slow one.
+type delivery struct {
+	reg   *clientReg
+	batch *pb.EventBatch
+}
+
+// enqueueBatch delivers batch to reg->ch according to reg.mode, updating
+// the relevant metrics. It never blocks indefinitely: BLOCK_WITH_DEADLINE
+// waits at most reg.blockDeadline before falling back to a drop. Returns
+// false if reg should be disconnected (only possible under
+// DISCONNECT_ON_OVERFLOW), in which case the caller must close
+// reg.disconnect.
+func enqueueBatch(reg *clientReg, batch *pb.EventBatch) bool {
+	select {
+	case reg.ch <- batch:
+		metrics.EventsTotal.WithLabelValues(reg.id).Add(float64(len(batch.GetEvents())))
+		return true
+	default:
+	}
+
+	switch reg.mode {
+	case pb.DeliveryMode_DROP_OLDEST:
+		select {
+		case <-reg.ch:
+			metrics.EventsDropped.WithLabelValues(reg.id, "drop_oldest").Inc()
+		default:
+		}
+		select {
+		case reg.ch <- batch:
+			metrics.EventsTotal.WithLabelValues(reg.id).Add(float64(len(batch.GetEvents())))
+		default:
+			// Another producer raced us and refilled the channel; drop
+			// rather than block the broadcaster for this one client.
+			metrics.EventsDropped.WithLabelValues(reg.id, "drop_oldest").Inc()
+		}
+		return true
+
+	case pb.DeliveryMode_BLOCK_WITH_DEADLINE:
+		timer := time.NewTimer(reg.blockDeadline)
+		defer timer.Stop()
+		select {
+		case reg.ch <- batch:
+			metrics.EventsTotal.WithLabelValues(reg.id).Add(float64(len(batch.GetEvents())))
+		case <-timer.C:
+			metrics.EventsDropped.WithLabelValues(reg.id, "block_deadline_exceeded").Inc()
+		}
+		return true
+
+	case pb.DeliveryMode_DISCONNECT_ON_OVERFLOW:
+		metrics.EventsDropped.WithLabelValues(reg.id, "disconnect_on_overflow").Inc()
+		return false
+
+	default: // pb.DeliveryMode_DROP_NEWEST
+		metrics.EventsDropped.WithLabelValues(reg.id, "drop_newest").Inc()
+		return true
+	}
+}
+
 // broadcastEvents is the main event processing loop.
 // It continuously reads events from the eBPF ring buffer and distributes
 // them to all connected clients.
@@ -212,8 +774,12 @@ func (s *server) StreamEvents(req *pb.Empty, stream pb.Tracker_StreamEventsServe
 //  1. Read raw event from ring buffer
 //  2. Parse binary data into Go struct
 //  3. Convert to protobuf format with timestamp correction
-//  4. Broadcast to all active client channels
-//  5. Skip slow clients to prevent blocking
+//  4. For each client whose filter matches, accumulate the event into its
+//     pending EventBatch, flushing once it hits the client's max batch size
+//     or flush interval
+//  5. Deliver each flushed batch per the client's DeliveryMode (see
+//     enqueueBatch), after releasing the client-map lock so one slow or
+//     blocking client can't stall fan-out to the rest
 //
 // This runs in a separate goroutine and terminates when ring buffer is closed.
 func (s *server) broadcastEvents() {
@@ -223,6 +789,7 @@ func (s *server) broadcastEvents() {
 			log.Printf("ringbuf read error: %v", err)
 			return
 		}
+		broadcastStart := time.Now()
 
 		// Parse the raw eBPF event data
 		var e event
@@ -233,6 +800,7 @@ func (s *server) broadcastEvents() {
 
 		// Convert monotonic timestamp to wall clock time
 		eventTime := s.bootTime.Add(time.Duration(e.Ts) * time.Nanosecond)
+		sc := syscallEnum(e.SyscallId)
 
 		// Create protobuf event with all available fields
 		pbEvent := &pb.Event{
@@ -241,28 +809,86 @@ func (s *server) broadcastEvents() {
 			Tid:     e.Tid,
 			Comm:    sanitizeString(e.Comm[:]),
 			Syscall: syscallName(e.SyscallId),
-			Path:    sanitizeString(e.Path[:]),
-			NewPath: sanitizeString(e.NewPath[:]),
+			Path:    resolveAbsolutePath(e.Pid, e.Dfd, sanitizeString(e.Path[:])),
+			NewPath: resolveAbsolutePath(e.Pid, atFDCWD, sanitizeString(e.NewPath[:])),
 			RetVal:  e.RetVal,
 			Bytes:   e.Bytes,
-			// TODO: Add flags, inode, mode, uid, gid in future iterations
-			Flags: pb.Event_O_RDONLY, // Default for now
+			Flags:   e.Flags,
+			Mode:    e.FSMode,
+			Uid:     e.Uid,
+			Gid:     e.Gid,
+			Dev:     e.Dev,
+			Inode:   e.Inode,
+		}
+
+		if sc == pb.Syscall_SYSCALL_WRITE {
+			pbEvent.Entropy = float32(e.EntropyQ8) / 256
+			pbEvent.SampleBytes = uint32(e.SampleBytes)
+			// e.Magic is already zero-padded to its full width by the
+			// sampler (tracepoints.c), so it's always safe to send whole.
+			if e.SampleBytes > 0 {
+				pbEvent.Magic = append([]byte(nil), e.Magic[:]...)
+			}
+			pbEvent.SuspicionScore = float32(s.scoreSuspicion(suspicionKey{pid: e.Pid, inode: e.Inode}, e.OpenFlags, e.EntropyQ8, e.SampleBytes > 0))
+		}
+
+		// Rename always gets a call stack; it's rare enough to be cheap and
+		// is the strongest single signal for a ransomware encryptor loop.
+		// Writes only get one once they're flagged suspicious, since
+		// symbolizing every write would be far too expensive for the hot
+		// path.
+		if sc == pb.Syscall_SYSCALL_RENAME || (sc == pb.Syscall_SYSCALL_WRITE && pbEvent.SuspicionScore > 0) {
+			pbEvent.Stack = resolveStack(s.stacks, s.resolver, e.Pid, e.StackId)
 		}
 
-		batch := &pb.EventBatch{Events: []*pb.Event{pbEvent}}
+		// Drops since the previous iteration. Read once per event and used
+		// both for the journaled record and to credit every registered
+		// client - not swapped-and-cleared, since most iterations don't
+		// flush any given client's pending batch and a swap would discard
+		// the count before it ever reached an EventBatch.LostSamples.
+		total := atomic.LoadUint64(&s.lostSamples)
+		lostDelta := total - s.lastLostSamples
+		s.lastLostSamples = total
+
+		// Append to the write-ahead journal before fan-out, so this event
+		// survives a tracker restart and a late-connecting client can
+		// replay it instead of only ever seeing the live stream.
+		if _, err := s.journal.Append(&pb.EventBatch{Events: []*pb.Event{pbEvent}, LostSamples: lostDelta}); err != nil {
+			log.Printf("journal append error: %v", err)
+		}
 
-		// Broadcast to all connected clients
+		// Evaluate each client's filter and accumulate matches into its
+		// pending batch, collecting the batches to flush without holding
+		// s.mu during delivery (see delivery/enqueueBatch).
 		s.mu.Lock()
-		for ch := range s.clients {
-			select {
-			case ch <- batch:
-				// Event sent successfully
-			default:
-				// Skip if channel is full to avoid blocking
-				// This prevents slow clients from affecting overall performance
+		now := time.Now()
+		var deliveries []delivery
+		for _, reg := range s.clients {
+			reg.lostSamples += lostDelta
+			if !reg.filter.matches(pbEvent, sc, e.Uid, e.Gid) {
+				continue
 			}
+			reg.pending = append(reg.pending, pbEvent)
+			if len(reg.pending) < reg.maxBatchSize && now.Sub(reg.lastFlush) < reg.maxFlushInterval {
+				continue
+			}
+			deliveries = append(deliveries, delivery{
+				reg:   reg,
+				batch: &pb.EventBatch{Events: reg.pending, LostSamples: reg.lostSamples},
+			})
+			reg.pending = nil
+			reg.lostSamples = 0
+			reg.lastFlush = now
 		}
 		s.mu.Unlock()
+
+		for _, d := range deliveries {
+			metrics.ClientLag.WithLabelValues(d.reg.id).Set(now.Sub(eventTime).Seconds())
+			if !enqueueBatch(d.reg, d.batch) {
+				d.reg.disconnectOnce.Do(func() { close(d.reg.disconnect) })
+			}
+		}
+		metrics.BroadcastLatency.Observe(time.Since(broadcastStart).Seconds())
 	}
 }
 
@@ -277,18 +903,48 @@ func (s *server) broadcastEvents() {
 //	SyscallId: Our custom syscall identifier (1=openat, 2=write, 3=rename)
 //	RetVal: System call return value (file descriptor or error)
 //	Bytes: Number of bytes for write operations
+//	StackId: Index into the stack_traces map for write/rename events, or
+//	  negative if bpf_get_stackid found no user stack
+//	Flags: openat() flags argument, 0 for write/rename
+//	FSMode: Target inode's mode bits (i_mode), openat only
+//	Uid/Gid: Caller's credentials at the time of the syscall
+//	Dev/Inode: Target inode's device and inode number, openat only -
+//	  these are what let downstream consumers key an event to a file
+//	  across bind mounts and chroots instead of trusting a raw path
+//	Dfd: dirfd passed to openat (AT_FDCWD if none), used to resolve
+//	  Path to an absolute path in userspace
+//	EntropyQ8: Shannon entropy of the sampled write buffer, Q8.8 fixed
+//	  point bits-per-nibble (0..~4.0); 0 for non-write events or writes
+//	  the sampler skipped
+//	SampleBytes: Number of bytes actually sampled for EntropyQ8/Magic
+//	Magic: First bytes of the sampled write buffer
+//	OpenFlags: Flags the fd was opened with, write only - lets
+//	  scoreSuspicion recognize an O_WRONLY|O_TRUNC overwrite-in-place
+//	  without re-deriving it from a separate openat event
 //	Path: File path for openat/rename (up to 256 chars)
 //	NewPath: Destination path for rename operations
 type event struct {
-	Ts        uint64
-	Pid       uint32
-	Tid       uint32
-	Comm      [16]byte
-	SyscallId uint32
-	RetVal    int64
-	Bytes     uint64
-	Path      [256]byte
-	NewPath   [256]byte
+	Ts          uint64
+	Pid         uint32
+	Tid         uint32
+	Comm        [16]byte
+	SyscallId   uint32
+	RetVal      int64
+	Bytes       uint64
+	StackId     int64
+	Flags       uint32
+	FSMode      uint32
+	Uid         uint32
+	Gid         uint32
+	Dev         uint64
+	Inode       uint64
+	Dfd         int64
+	EntropyQ8   uint16
+	SampleBytes uint16
+	Magic       [8]byte
+	OpenFlags   uint32
+	Path        [256]byte
+	NewPath     [256]byte
 }
 
 // syscallName converts our custom syscall IDs to human-readable names.
@@ -314,6 +970,21 @@ func syscallName(id uint32) string {
 	}
 }
 
+// syscallEnum converts our custom syscall IDs to the pb.Syscall enum used
+// for filtering. Keep in sync with syscallName.
+func syscallEnum(id uint32) pb.Syscall {
+	switch id {
+	case 1:
+		return pb.Syscall_SYSCALL_OPENAT
+	case 2:
+		return pb.Syscall_SYSCALL_WRITE
+	case 3:
+		return pb.Syscall_SYSCALL_RENAME
+	default:
+		return pb.Syscall_SYSCALL_UNKNOWN
+	}
+}
+
 // sanitizeString converts byte arrays from eBPF into clean UTF-8 strings.
 // eBPF strings are null-terminated and may contain invalid UTF-8 sequences.
 //
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"testing"
+
+	pb "github.com/Itz-Agasta/nerrf/tracker/pkg/pb"
+)
+
+func TestCompiledFilterMatches(t *testing.T) {
+	cf := compileFilter(&pb.Filter{
+		Syscalls:      []pb.Syscall{pb.Syscall_SYSCALL_WRITE},
+		CommPrefix:    "enc",
+		MinWriteBytes: 100,
+	})
+
+	write := &pb.Event{Comm: "encryptor", Bytes: 200}
+	if !cf.matches(write, pb.Syscall_SYSCALL_WRITE, 0, 0) {
+		t.Error("matches() = false for a write event satisfying every predicate, want true")
+	}
+	if cf.matches(write, pb.Syscall_SYSCALL_OPENAT, 0, 0) {
+		t.Error("matches() = true for the wrong syscall, want false")
+	}
+
+	small := &pb.Event{Comm: "encryptor", Bytes: 10}
+	if cf.matches(small, pb.Syscall_SYSCALL_WRITE, 0, 0) {
+		t.Error("matches() = true for a write below min_write_bytes, want false")
+	}
+
+	other := &pb.Event{Comm: "bash", Bytes: 200}
+	if cf.matches(other, pb.Syscall_SYSCALL_WRITE, 0, 0) {
+		t.Error("matches() = true for a comm not matching comm_prefix, want false")
+	}
+}
+
+func TestCompiledFilterNilMatchesEverything(t *testing.T) {
+	cf := compileFilter(nil)
+	e := &pb.Event{Comm: "anything"}
+	if !cf.matches(e, pb.Syscall_SYSCALL_RENAME, 999, 999) {
+		t.Error("matches() = false for a nil Filter, want true (nil Filter matches every event)")
+	}
+}
+
+func TestScoreSuspicionSkipsEWMAForUnsampledWrites(t *testing.T) {
+	s := &server{suspects: make(map[suspicionKey]*suspicionState)}
+	key := suspicionKey{pid: 1, inode: 2}
+
+	// A truncating open followed by one sampled high-entropy write should
+	// push the score above zero.
+	s.scoreSuspicion(key, oWRONLY|oTRUNC, 1024, true)
+	baseline := s.suspects[key].entropyEWMA
+
+	// A burst of unsampled writes (entropyQ8 == 0, sampled == false, as
+	// TRACKER_WRITE_SAMPLE_RATE < 1.0 produces for most writes) must not
+	// drag entropyEWMA back toward zero.
+	for i := 0; i < 10; i++ {
+		s.scoreSuspicion(key, oWRONLY|oTRUNC, 0, false)
+	}
+	if got := s.suspects[key].entropyEWMA; got != baseline {
+		t.Errorf("entropyEWMA after unsampled writes = %v, want unchanged %v", got, baseline)
+	}
+}
+
+func TestScoreSuspicionRequiresTruncatingOpen(t *testing.T) {
+	s := &server{suspects: make(map[suspicionKey]*suspicionState)}
+	key := suspicionKey{pid: 1, inode: 2}
+
+	score := s.scoreSuspicion(key, 0, 1024, true)
+	if score != 0 {
+		t.Errorf("score = %v for a non-truncating open with high entropy, want 0", score)
+	}
+}
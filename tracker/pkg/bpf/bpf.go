@@ -0,0 +1,89 @@
+// Package bpf loads and attaches the NERRF tracepoint programs.
+//
+// Bindings for the compiled eBPF objects are generated by bpf2go from
+// ../../bpf/tracepoints.c into tracepoints_bpfel.go / tracepoints_bpfeb.go
+// (the matching one is picked automatically for the host's endianness,
+// each embedding its object file via go:embed). They are build output, not
+// source: bpf2go needs vmlinux.h dumped from the build host's own kernel
+// BTF (see tracker/Makefile's vendor-headers target), so the generated
+// files aren't portable between machines and aren't committed. Build with:
+//
+//	make build
+//
+// which runs vendor-headers and `go generate ./...` before compiling; see
+// tracker/Makefile and .github/workflows/tracker-ci.yml for the exact
+// steps CI runs.
+package bpf
+
+//go:generate go run github.com/cilium/ebpf/cmd/bpf2go -cc clang -cflags "-O2 -g -Wall" tracepoints ../../bpf/tracepoints.c -- -I../../bpf/headers
+
+import (
+	"fmt"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/link"
+)
+
+// Objects groups the loaded eBPF programs and maps for the tracepoint
+// collection. It embeds the bpf2go-generated tracepointsObjects so callers
+// get typed access to every program and map - including the ring buffer -
+// instead of passing around untyped *ebpf.Map/*ebpf.Program values.
+type Objects struct {
+	tracepointsObjects
+}
+
+// Config mirrors the eBPF `struct config` in tracepoints.c: runtime-tunable
+// knobs written into the program's config map right after loading, so they
+// don't require a recompile.
+type Config struct {
+	// WriteSampleRateBps is the chance, in basis points (0-10000), that a
+	// given write's buffer is sampled for entropy. 10000 samples every
+	// write; 0 disables sampling entirely.
+	WriteSampleRateBps uint32
+}
+
+// Load reads the embedded eBPF object file (selected for the host's
+// endianness by bpf2go), loads it into the kernel, and applies cfg. The
+// object bytes ship inside the binary via go:embed, so there is no
+// tracepoints.o to locate relative to the executable and nothing to
+// os.Stat before loading.
+func Load(cfg Config) (*Objects, error) {
+	objs := &Objects{}
+	if err := loadTracepointsObjects(&objs.tracepointsObjects, nil); err != nil {
+		return nil, fmt.Errorf("load bpf objects: %w", err)
+	}
+	if err := objs.Config.Update(uint32(0), cfg, ebpf.UpdateAny); err != nil {
+		return nil, fmt.Errorf("set bpf config: %w", err)
+	}
+	return objs, nil
+}
+
+// Attach attaches every tracepoint program in objs to its kernel tracepoint
+// and returns the resulting links. On error it closes any links it already
+// opened before returning. Callers are responsible for closing the returned
+// links (and objs) on shutdown.
+func Attach(objs *Objects) ([]link.Link, error) {
+	attachments := []struct {
+		group string
+		name  string
+		prog  *ebpf.Program
+	}{
+		{"syscalls", "sys_enter_openat", objs.HandleOpenatEnter},
+		{"syscalls", "sys_exit_openat", objs.HandleOpenatExit},
+		{"syscalls", "sys_enter_write", objs.HandleWrite},
+		{"syscalls", "sys_enter_rename", objs.HandleRename},
+	}
+
+	links := make([]link.Link, 0, len(attachments))
+	for _, a := range attachments {
+		l, err := link.Tracepoint(a.group, a.name, a.prog, nil)
+		if err != nil {
+			for _, existing := range links {
+				existing.Close()
+			}
+			return nil, fmt.Errorf("attach %s/%s: %w", a.group, a.name, err)
+		}
+		links = append(links, l)
+	}
+	return links, nil
+}
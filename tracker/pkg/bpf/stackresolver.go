@@ -0,0 +1,245 @@
+package bpf
+
+import (
+	"bufio"
+	"debug/elf"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// StackFrame is one resolved frame in a user-space stack trace: the raw
+// instruction-pointer address plus, when symbolization succeeded, the
+// module it falls inside and the nearest preceding symbol.
+type StackFrame struct {
+	Addr   uint64
+	Module string
+	Symbol string
+	Offset uint64
+}
+
+// StackResolver symbolizes user-space stack traces captured via
+// bpf_get_stackid, resolving raw addresses against each process's memory
+// map and the ELF symbol tables of its mapped files.
+//
+// Resolution is lazy by design: callers should only invoke Resolve for
+// events worth the cost (rename, or a write flagged by the entropy
+// estimator) rather than on every syscall, since reading /proc/<pid>/maps
+// and parsing ELF symbol tables is far too expensive for the hot path.
+// Per-process module lists are cached and keyed by the (dev, inode) of the
+// process's executable, so an exec'd binary invalidates the cache instead
+// of symbolizing against a stale image.
+type StackResolver struct {
+	mu    sync.Mutex
+	procs map[uint32]*procModules
+}
+
+// NewStackResolver returns an empty StackResolver ready for use.
+func NewStackResolver() *StackResolver {
+	return &StackResolver{procs: make(map[uint32]*procModules)}
+}
+
+// moduleKey identifies a mapped file well enough to detect that a pid has
+// exec'd a different binary since it was last cached.
+type moduleKey struct {
+	dev   uint64
+	inode uint64
+}
+
+// module is one file-backed mapping from /proc/<pid>/maps, plus its parsed
+// symbol table.
+type module struct {
+	path    string
+	start   uint64
+	end     uint64
+	fileOff uint64
+	symbols []elf.Symbol // sorted by Value, functions only
+}
+
+type procModules struct {
+	key     moduleKey // dev/inode of /proc/<pid>/exe when this was built
+	modules []module  // sorted by start
+}
+
+// Resolve symbolizes every non-zero address in raw (as read from the
+// BPF_MAP_TYPE_STACK_TRACE map for a given stack ID) against pid's current
+// address space, rebuilding the cached module list if pid has since exec'd
+// or the pid was never seen before.
+func (r *StackResolver) Resolve(pid uint32, raw []uint64) ([]StackFrame, error) {
+	pm, err := r.modulesFor(pid)
+	if err != nil {
+		return nil, err
+	}
+
+	frames := make([]StackFrame, 0, len(raw))
+	for _, addr := range raw {
+		if addr == 0 {
+			continue
+		}
+		frames = append(frames, pm.resolve(addr))
+	}
+	return frames, nil
+}
+
+func (r *StackResolver) modulesFor(pid uint32) (*procModules, error) {
+	exeKey, err := execKey(pid)
+	if err != nil {
+		return nil, fmt.Errorf("stat exe for pid %d: %w", pid, err)
+	}
+
+	r.mu.Lock()
+	pm, ok := r.procs[pid]
+	r.mu.Unlock()
+	if ok && pm.key == exeKey {
+		return pm, nil
+	}
+
+	pm, err = loadProcModules(pid, exeKey)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.procs[pid] = pm
+	r.mu.Unlock()
+	return pm, nil
+}
+
+// execKey returns the (dev, inode) of /proc/<pid>/exe, used to detect that a
+// pid has exec'd a new binary since it was cached.
+func execKey(pid uint32) (moduleKey, error) {
+	fi, err := os.Stat(fmt.Sprintf("/proc/%d/exe", pid))
+	if err != nil {
+		return moduleKey{}, err
+	}
+	return statToKey(fi)
+}
+
+// statToKey extracts the (dev, inode) pair identifying a file from its
+// os.FileInfo, as reported by the Linux syscall.Stat_t.
+func statToKey(fi os.FileInfo) (moduleKey, error) {
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return moduleKey{}, fmt.Errorf("unsupported stat type for %s", fi.Name())
+	}
+	return moduleKey{dev: uint64(st.Dev), inode: st.Ino}, nil
+}
+
+// resolve finds the module containing addr (if any) and the nearest symbol
+// at or before the corresponding file offset.
+func (pm *procModules) resolve(addr uint64) StackFrame {
+	for _, m := range pm.modules {
+		if addr < m.start || addr >= m.end {
+			continue
+		}
+		fileAddr := addr - m.start + m.fileOff
+		frame := StackFrame{Addr: addr, Module: m.path}
+		if sym, ok := nearestSymbol(m.symbols, fileAddr); ok {
+			frame.Symbol = sym.Name
+			frame.Offset = fileAddr - sym.Value
+		}
+		return frame
+	}
+	return StackFrame{Addr: addr}
+}
+
+// nearestSymbol returns the last function symbol whose value is <= addr.
+func nearestSymbol(symbols []elf.Symbol, addr uint64) (elf.Symbol, bool) {
+	i := sort.Search(len(symbols), func(i int) bool { return symbols[i].Value > addr })
+	if i == 0 {
+		return elf.Symbol{}, false
+	}
+	return symbols[i-1], true
+}
+
+// loadProcModules parses /proc/<pid>/maps and loads the ELF symbol table of
+// every distinct executable mapping.
+func loadProcModules(pid uint32, key moduleKey) (*procModules, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/maps", pid))
+	if err != nil {
+		return nil, fmt.Errorf("open maps for pid %d: %w", pid, err)
+	}
+	defer f.Close()
+
+	symbolCache := make(map[string][]elf.Symbol)
+	pm := &procModules{key: key}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		m, ok := parseMapsLine(scanner.Text())
+		if !ok {
+			continue
+		}
+		syms, cached := symbolCache[m.path]
+		if !cached {
+			syms = loadSymbols(m.path)
+			symbolCache[m.path] = syms
+		}
+		m.symbols = syms
+		pm.modules = append(pm.modules, m)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan maps for pid %d: %w", pid, err)
+	}
+
+	sort.Slice(pm.modules, func(i, j int) bool { return pm.modules[i].start < pm.modules[j].start })
+	return pm, nil
+}
+
+// parseMapsLine parses one line of /proc/<pid>/maps, keeping only mappings
+// backed by a regular file (anonymous/stack/heap mappings have no symbols
+// to resolve against).
+//
+// Example line:
+//
+//	7f1a2b400000-7f1a2b428000 r-xp 00001000 08:01 131099  /usr/lib/x86_64-linux-gnu/libc.so.6
+func parseMapsLine(line string) (module, bool) {
+	fields := strings.Fields(line)
+	if len(fields) < 6 {
+		return module{}, false
+	}
+	path := fields[5]
+	if !strings.HasPrefix(path, "/") {
+		return module{}, false
+	}
+
+	addrs := strings.SplitN(fields[0], "-", 2)
+	if len(addrs) != 2 {
+		return module{}, false
+	}
+	start, err1 := strconv.ParseUint(addrs[0], 16, 64)
+	end, err2 := strconv.ParseUint(addrs[1], 16, 64)
+	off, err3 := strconv.ParseUint(fields[2], 16, 64)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return module{}, false
+	}
+
+	return module{path: path, start: start, end: end, fileOff: off}, true
+}
+
+// loadSymbols reads the function symbol table from an ELF file. Errors (the
+// file is a script, stripped, or no longer exists) are swallowed - a module
+// with no symbols still contributes its address range for the Module field.
+func loadSymbols(path string) []elf.Symbol {
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	syms, err := f.Symbols()
+	if err != nil {
+		return nil
+	}
+	funcs := syms[:0]
+	for _, s := range syms {
+		if elf.ST_TYPE(s.Info) == elf.STT_FUNC && s.Value != 0 {
+			funcs = append(funcs, s)
+		}
+	}
+	sort.Slice(funcs, func(i, j int) bool { return funcs[i].Value < funcs[j].Value })
+	return funcs
+}
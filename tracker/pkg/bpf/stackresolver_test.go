@@ -0,0 +1,37 @@
+package bpf
+
+import (
+	"debug/elf"
+	"testing"
+)
+
+func TestNearestSymbol(t *testing.T) {
+	symbols := []elf.Symbol{
+		{Name: "foo", Value: 0x1000},
+		{Name: "bar", Value: 0x2000},
+		{Name: "baz", Value: 0x3000},
+	}
+
+	cases := []struct {
+		addr   uint64
+		want   string
+		wantOK bool
+	}{
+		{addr: 0x0500, wantOK: false},
+		{addr: 0x1000, want: "foo", wantOK: true},
+		{addr: 0x1fff, want: "foo", wantOK: true},
+		{addr: 0x2500, want: "bar", wantOK: true},
+		{addr: 0x9000, want: "baz", wantOK: true},
+	}
+
+	for _, c := range cases {
+		sym, ok := nearestSymbol(symbols, c.addr)
+		if ok != c.wantOK {
+			t.Errorf("nearestSymbol(%#x) ok = %v, want %v", c.addr, ok, c.wantOK)
+			continue
+		}
+		if ok && sym.Name != c.want {
+			t.Errorf("nearestSymbol(%#x) = %q, want %q", c.addr, sym.Name, c.want)
+		}
+	}
+}
@@ -0,0 +1,525 @@
+// Package journal implements a local, append-only write-ahead log of
+// EventBatches, so the tracker's "undo computing" promise survives a
+// restart and a late-connecting consumer can catch up instead of starting
+// from whatever happens to be streaming live.
+//
+// A Journal is a directory of segment files, each holding a sequence of
+// length-prefixed, protobuf-encoded EventBatch records. Segments roll over
+// once they reach MaxSegmentBytes and are named by a monotonically
+// increasing index, so replay and compaction can both work purely off
+// directory order.
+package journal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/Itz-Agasta/nerrf/tracker/pkg/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// MaxSegmentBytes is the default size at which a segment is closed and a
+// new one started.
+const MaxSegmentBytes = 64 << 20 // 64 MiB
+
+// recordHeaderSize is the length prefix on every record: a uint32 byte
+// count for the marshaled EventBatch that follows it.
+const recordHeaderSize = 4
+
+// maxRecordBytes bounds the length prefix readRecord will trust before
+// allocating a buffer for it. No legitimate EventBatch comes anywhere
+// close to this (batches are capped client-side to a few dozen events,
+// see defaultMaxBatchSize in cmd/tracker), so a header claiming more is
+// corrupt framing - most likely a torn record header overlapping what was
+// meant to be the next record's bytes - not a record we should ever try
+// to fully allocate for.
+const maxRecordBytes = 64 << 20 // 64 MiB
+
+// FsyncPolicy controls how aggressively Append durably flushes to disk.
+// Fsyncing on every write is the only way to guarantee zero event loss on
+// a crash, but it's also the slowest option - interval and never trade
+// some of that guarantee for throughput.
+type FsyncPolicy int
+
+const (
+	// FsyncAlways fsyncs after every Append.
+	FsyncAlways FsyncPolicy = iota
+	// FsyncInterval fsyncs at most once per FsyncInterval.
+	FsyncInterval
+	// FsyncNever relies on the OS page cache and segment rotation/close to
+	// eventually flush; fastest, least durable.
+	FsyncNever
+)
+
+// ParseFsyncPolicy parses the TRACKER_JOURNAL_FSYNC environment variable.
+func ParseFsyncPolicy(s string) (FsyncPolicy, error) {
+	switch s {
+	case "", "always":
+		return FsyncAlways, nil
+	case "interval":
+		return FsyncInterval, nil
+	case "never":
+		return FsyncNever, nil
+	default:
+		return 0, fmt.Errorf("invalid fsync policy %q (want always|interval|never)", s)
+	}
+}
+
+// Checkpoint identifies a position in the journal: a segment and a byte
+// offset within it. A client can save one from a CheckpointResponse and
+// later resume streaming from exactly that point.
+type Checkpoint struct {
+	SegmentID uint64
+	Offset    int64
+}
+
+// Journal is a segmented, append-only log of EventBatches.
+type Journal struct {
+	dir             string
+	maxSegmentBytes int64
+	fsyncPolicy     FsyncPolicy
+	fsyncInterval   time.Duration
+	mu              sync.Mutex
+	segmentIDs      []uint64 // ascending, oldest first
+	cur             *os.File
+	curID           uint64
+	curSize         int64
+	lastFsync       time.Time
+}
+
+// Option configures a Journal constructed by Open.
+type Option func(*Journal)
+
+// WithMaxSegmentBytes overrides MaxSegmentBytes.
+func WithMaxSegmentBytes(n int64) Option {
+	return func(j *Journal) { j.maxSegmentBytes = n }
+}
+
+// WithFsyncPolicy overrides the default FsyncAlways.
+func WithFsyncPolicy(p FsyncPolicy, interval time.Duration) Option {
+	return func(j *Journal) {
+		j.fsyncPolicy = p
+		j.fsyncInterval = interval
+	}
+}
+
+// Open opens (creating if necessary) the journal rooted at dir, resuming
+// onto the newest existing segment if one exists.
+func Open(dir string, opts ...Option) (*Journal, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create journal dir: %w", err)
+	}
+
+	j := &Journal{
+		dir:             dir,
+		maxSegmentBytes: MaxSegmentBytes,
+		fsyncPolicy:     FsyncAlways,
+		fsyncInterval:   time.Second,
+	}
+	for _, opt := range opts {
+		opt(j)
+	}
+
+	ids, err := listSegmentIDs(dir)
+	if err != nil {
+		return nil, err
+	}
+	j.segmentIDs = ids
+
+	if len(ids) == 0 {
+		if err := j.openSegment(1); err != nil {
+			return nil, err
+		}
+	} else if err := j.resumeSegment(ids[len(ids)-1]); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// Append marshals batch and appends it as one length-prefixed record,
+// rotating to a new segment first if the current one is full. It returns
+// the checkpoint identifying the record's segment and end offset, so a
+// caller can hand it to a client that asks for one.
+func (j *Journal) Append(batch *pb.EventBatch) (Checkpoint, error) {
+	payload, err := proto.Marshal(batch)
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("marshal batch: %w", err)
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	// Only rotate a segment that already holds something: rotating an empty
+	// one would just leave it behind forever (Compact only drops a segment
+	// once it has a newest-record timestamp older than retention, and an
+	// empty segment never gets one), so a MaxSegmentBytes smaller than a
+	// single record would otherwise leak one dead empty segment per Append.
+	if j.curSize > 0 && j.curSize+int64(recordHeaderSize+len(payload)) > j.maxSegmentBytes {
+		if err := j.rotateLocked(); err != nil {
+			return Checkpoint{}, err
+		}
+	}
+
+	var header [recordHeaderSize]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(payload)))
+	if _, err := j.cur.Write(header[:]); err != nil {
+		return Checkpoint{}, fmt.Errorf("write record header: %w", err)
+	}
+	if _, err := j.cur.Write(payload); err != nil {
+		return Checkpoint{}, fmt.Errorf("write record: %w", err)
+	}
+	j.curSize += int64(recordHeaderSize + len(payload))
+
+	if err := j.maybeFsyncLocked(); err != nil {
+		return Checkpoint{}, err
+	}
+
+	return Checkpoint{SegmentID: j.curID, Offset: j.curSize}, nil
+}
+
+func (j *Journal) maybeFsyncLocked() error {
+	switch j.fsyncPolicy {
+	case FsyncAlways:
+		return j.cur.Sync()
+	case FsyncInterval:
+		if time.Since(j.lastFsync) >= j.fsyncInterval {
+			j.lastFsync = time.Now()
+			return j.cur.Sync()
+		}
+	}
+	return nil
+}
+
+func (j *Journal) rotateLocked() error {
+	if j.cur != nil {
+		if err := j.cur.Close(); err != nil {
+			return fmt.Errorf("close segment %d: %w", j.curID, err)
+		}
+	}
+	return j.openSegment(j.curID + 1)
+}
+
+func (j *Journal) openSegment(id uint64) error {
+	f, err := os.OpenFile(segmentPath(j.dir, id), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("open segment %d: %w", id, err)
+	}
+	j.cur = f
+	j.curID = id
+	j.curSize = 0
+	j.segmentIDs = append(j.segmentIDs, id)
+	return nil
+}
+
+// resumeSegment reopens the newest existing segment for append, so a
+// restarted tracker keeps filling it instead of always starting a fresh
+// one. If the segment's tail is torn - a partial header or payload, which
+// is exactly what a crash mid-Append leaves behind - it's truncated back
+// to the last fully-written record first, so the append that follows
+// doesn't permanently desync the length-prefix framing for every record
+// written after it.
+func (j *Journal) resumeSegment(id uint64) error {
+	path := segmentPath(j.dir, id)
+	validSize, err := scanValidLength(path)
+	if err != nil {
+		return fmt.Errorf("validate segment %d: %w", id, err)
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("stat segment %d: %w", id, err)
+	}
+	if validSize < fi.Size() {
+		log.Printf("journal: segment %d has a torn tail (%d of %d bytes are well-formed records), truncating", id, validSize, fi.Size())
+		if err := os.Truncate(path, validSize); err != nil {
+			return fmt.Errorf("truncate torn segment %d: %w", id, err)
+		}
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("reopen segment %d: %w", id, err)
+	}
+	j.cur = f
+	j.curID = id
+	j.curSize = validSize
+	return nil
+}
+
+// scanValidLength walks segment id from the start and returns the byte
+// offset just past the last fully-written, well-formed record - i.e. where
+// a torn or corrupt trailing record begins - or the file's full size if
+// every record is intact.
+func scanValidLength(path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("open segment for validation: %w", err)
+	}
+	defer f.Close()
+
+	var offset int64
+	for {
+		_, recordLen, err := readRecord(f)
+		if err != nil {
+			// Both a clean end-of-file and a torn/corrupt trailing record
+			// stop the scan here; offset is the last good boundary either
+			// way.
+			return offset, nil
+		}
+		offset += recordLen
+	}
+}
+
+// Checkpoint returns the current write position: the segment being
+// appended to and the number of bytes written to it so far.
+func (j *Journal) Checkpoint() Checkpoint {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return Checkpoint{SegmentID: j.curID, Offset: j.curSize}
+}
+
+// Close closes the current segment.
+func (j *Journal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.cur.Close()
+}
+
+// ReplayFunc is called with each batch read during Replay/ReplayFrom.
+// Returning an error stops replay early.
+type ReplayFunc func(*pb.EventBatch) error
+
+// ReplayFrom streams every record after cp, in order, across segments,
+// until it reaches the end of the journal as it stood when ReplayFrom was
+// called (it does not block waiting for new writes - callers that want a
+// gapless switch to live events should start the live stream before or
+// immediately after calling ReplayFrom, as StreamEvents does).
+func (j *Journal) ReplayFrom(cp Checkpoint, fn ReplayFunc) error {
+	j.mu.Lock()
+	ids := append([]uint64(nil), j.segmentIDs...)
+	j.mu.Unlock()
+
+	for _, id := range ids {
+		if id < cp.SegmentID {
+			continue
+		}
+		offset := int64(0)
+		if id == cp.SegmentID {
+			offset = cp.Offset
+		}
+		if err := j.replaySegment(id, offset, nil, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Replay streams every event whose timestamp falls in [from, to] and
+// matches filter (nil filter matches everything). Each returned batch
+// contains only the events from the original batch that matched.
+func (j *Journal) Replay(from, to time.Time, filter func(*pb.Event) bool, fn ReplayFunc) error {
+	j.mu.Lock()
+	ids := append([]uint64(nil), j.segmentIDs...)
+	j.mu.Unlock()
+
+	window := func(b *pb.EventBatch) *pb.EventBatch {
+		filtered := make([]*pb.Event, 0, len(b.GetEvents()))
+		for _, e := range b.GetEvents() {
+			ts := e.GetTs().AsTime()
+			if !from.IsZero() && ts.Before(from) {
+				continue
+			}
+			if !to.IsZero() && ts.After(to) {
+				continue
+			}
+			if filter != nil && !filter(e) {
+				continue
+			}
+			filtered = append(filtered, e)
+		}
+		if len(filtered) == 0 {
+			return nil
+		}
+		return &pb.EventBatch{Events: filtered, LostSamples: b.GetLostSamples()}
+	}
+
+	for _, id := range ids {
+		if err := j.replaySegment(id, 0, window, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *Journal) replaySegment(id uint64, startOffset int64, transform func(*pb.EventBatch) *pb.EventBatch, fn ReplayFunc) error {
+	f, err := os.Open(segmentPath(j.dir, id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // compacted away since the caller listed segments
+		}
+		return fmt.Errorf("open segment %d: %w", id, err)
+	}
+	defer f.Close()
+
+	if startOffset > 0 {
+		if _, err := f.Seek(startOffset, io.SeekStart); err != nil {
+			return fmt.Errorf("seek segment %d: %w", id, err)
+		}
+	}
+
+	for {
+		batch, _, err := readRecord(f)
+		if err == io.EOF {
+			// Also reached for a torn trailing record (see readRecord) -
+			// that's the crash-mid-Append case this journal exists to
+			// survive, not a failure worth stopping replay over.
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read record in segment %d: %w", id, err)
+		}
+		if transform != nil {
+			batch = transform(batch)
+			if batch == nil {
+				continue
+			}
+		}
+		if err := fn(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readRecord reads one length-prefixed record from f. It returns io.EOF
+// both at a clean segment boundary and when the tail is torn - a partial
+// header, or a payload shorter than its header promised - which is
+// exactly what's left behind when a write is interrupted by a crash
+// mid-Append, so callers can treat the two identically instead of hard
+// failing on a condition this subsystem is meant to survive. On success,
+// recordLen is the total number of bytes the record occupied (header +
+// payload), for callers tracking a byte offset.
+func readRecord(f *os.File) (batch *pb.EventBatch, recordLen int64, err error) {
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(f, header[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, 0, io.EOF
+		}
+		return nil, 0, err
+	}
+
+	size := binary.BigEndian.Uint32(header[:])
+	if size > maxRecordBytes {
+		return nil, 0, fmt.Errorf("record header claims %d bytes, exceeds sanity cap of %d (corrupt or torn?)", size, maxRecordBytes)
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(f, payload); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, 0, io.EOF
+		}
+		return nil, 0, err
+	}
+
+	batch = &pb.EventBatch{}
+	if err := proto.Unmarshal(payload, batch); err != nil {
+		return nil, 0, fmt.Errorf("unmarshal record: %w", err)
+	}
+	return batch, int64(recordHeaderSize) + int64(size), nil
+}
+
+// Compact deletes every fully-written segment (i.e. not the one currently
+// being appended to) whose newest record is older than retention. It's
+// meant to be called periodically, e.g. from a time.Ticker in main.
+func (j *Journal) Compact(retention time.Duration) error {
+	cutoff := time.Now().Add(-retention)
+
+	j.mu.Lock()
+	ids := append([]uint64(nil), j.segmentIDs...)
+	curID := j.curID
+	j.mu.Unlock()
+
+	var kept []uint64
+	for _, id := range ids {
+		if id == curID {
+			kept = append(kept, id)
+			continue
+		}
+		newest, ok, err := newestTimestamp(segmentPath(j.dir, id))
+		if err != nil {
+			return err
+		}
+		if ok && newest.Before(cutoff) {
+			if err := os.Remove(segmentPath(j.dir, id)); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("remove segment %d: %w", id, err)
+			}
+			continue
+		}
+		kept = append(kept, id)
+	}
+
+	j.mu.Lock()
+	j.segmentIDs = kept
+	j.mu.Unlock()
+	return nil
+}
+
+// newestTimestamp scans a segment for the timestamp of its last event. A
+// torn or corrupt trailing record (see readRecord) doesn't invalidate the
+// timestamps already read from the records before it - Compact only needs
+// to know how recent the segment is, not that every byte of it is intact.
+func newestTimestamp(path string) (time.Time, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return time.Time{}, false, nil
+		}
+		return time.Time{}, false, err
+	}
+	defer f.Close()
+
+	var newest time.Time
+	found := false
+	for {
+		batch, _, err := readRecord(f)
+		if err != nil {
+			break
+		}
+		for _, e := range batch.GetEvents() {
+			ts := e.GetTs().AsTime()
+			if ts.After(newest) {
+				newest = ts
+				found = true
+			}
+		}
+	}
+	return newest, found, nil
+}
+
+func segmentPath(dir string, id uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d.journal", id))
+}
+
+func listSegmentIDs(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read journal dir: %w", err)
+	}
+	var ids []uint64
+	for _, e := range entries {
+		var id uint64
+		if _, err := fmt.Sscanf(e.Name(), "%020d.journal", &id); err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
@@ -0,0 +1,188 @@
+package journal
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/Itz-Agasta/nerrf/tracker/pkg/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func eventAt(ts time.Time) *pb.Event {
+	return &pb.Event{Ts: timestamppb.New(ts)}
+}
+
+func TestAppendAndReplayFrom(t *testing.T) {
+	dir := t.TempDir()
+	j, err := Open(dir, WithFsyncPolicy(FsyncNever, time.Second))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer j.Close()
+
+	cp, err := j.Append(&pb.EventBatch{Events: []*pb.Event{eventAt(time.Now())}})
+	if err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	if _, err := j.Append(&pb.EventBatch{Events: []*pb.Event{eventAt(time.Now())}}); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+
+	var replayed []*pb.EventBatch
+	if err := j.ReplayFrom(cp, func(b *pb.EventBatch) error {
+		replayed = append(replayed, b)
+		return nil
+	}); err != nil {
+		t.Fatalf("ReplayFrom: %v", err)
+	}
+	if len(replayed) != 1 {
+		t.Fatalf("ReplayFrom after first checkpoint: got %d batches, want 1 (the second Append only)", len(replayed))
+	}
+}
+
+func TestCheckpointMatchesAppend(t *testing.T) {
+	dir := t.TempDir()
+	j, err := Open(dir, WithFsyncPolicy(FsyncNever, time.Second))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer j.Close()
+
+	cp, err := j.Append(&pb.EventBatch{Events: []*pb.Event{eventAt(time.Now())}})
+	if err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	if got := j.Checkpoint(); got != cp {
+		t.Fatalf("Checkpoint() = %+v, want %+v (matching the last Append's return)", got, cp)
+	}
+}
+
+func TestReplayFiltersByTimeAndPreservesLostSamples(t *testing.T) {
+	dir := t.TempDir()
+	j, err := Open(dir, WithFsyncPolicy(FsyncNever, time.Second))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer j.Close()
+
+	early := time.Now().Add(-time.Hour)
+	late := time.Now()
+	if _, err := j.Append(&pb.EventBatch{Events: []*pb.Event{eventAt(early)}, LostSamples: 3}); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	if _, err := j.Append(&pb.EventBatch{Events: []*pb.Event{eventAt(late)}, LostSamples: 5}); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+
+	var got []*pb.EventBatch
+	err = j.Replay(late.Add(-time.Minute), time.Time{}, nil, func(b *pb.EventBatch) error {
+		got = append(got, b)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("Replay with from=%s: got %d batches, want 1 (only the late event)", late.Add(-time.Minute), len(got))
+	}
+	if got[0].GetLostSamples() != 5 {
+		t.Fatalf("LostSamples = %d, want 5 (carried forward from the journaled record)", got[0].GetLostSamples())
+	}
+}
+
+func TestAppendRotatesSegments(t *testing.T) {
+	dir := t.TempDir()
+	j, err := Open(dir, WithMaxSegmentBytes(1), WithFsyncPolicy(FsyncNever, time.Second))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer j.Close()
+
+	for i := 0; i < 3; i++ {
+		if _, err := j.Append(&pb.EventBatch{Events: []*pb.Event{eventAt(time.Now())}}); err != nil {
+			t.Fatalf("Append %d: %v", i, err)
+		}
+	}
+
+	ids, err := listSegmentIDs(dir)
+	if err != nil {
+		t.Fatalf("listSegmentIDs: %v", err)
+	}
+	if len(ids) != 3 {
+		t.Fatalf("segment count = %d, want 3 (one per Append, since WithMaxSegmentBytes(1) forces rotation every record)", len(ids))
+	}
+}
+
+func TestCompactRemovesOldSegmentsButKeepsCurrent(t *testing.T) {
+	dir := t.TempDir()
+	j, err := Open(dir, WithMaxSegmentBytes(1), WithFsyncPolicy(FsyncNever, time.Second))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer j.Close()
+
+	old := eventAt(time.Now().Add(-48 * time.Hour))
+	if _, err := j.Append(&pb.EventBatch{Events: []*pb.Event{old}}); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	recent := eventAt(time.Now())
+	if _, err := j.Append(&pb.EventBatch{Events: []*pb.Event{recent}}); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+
+	if err := j.Compact(time.Hour); err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+
+	ids, err := listSegmentIDs(dir)
+	if err != nil {
+		t.Fatalf("listSegmentIDs: %v", err)
+	}
+	if len(ids) != 1 {
+		t.Fatalf("segment count after Compact = %d, want 1 (the old segment dropped, the current one always kept)", len(ids))
+	}
+}
+
+func TestResumeSegmentTruncatesTornTail(t *testing.T) {
+	dir := t.TempDir()
+	j, err := Open(dir, WithFsyncPolicy(FsyncNever, time.Second))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	if _, err := j.Append(&pb.EventBatch{Events: []*pb.Event{eventAt(time.Now())}}); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	validSize := j.curSize
+	// Simulate a crash mid-Append: a length prefix with no payload behind it.
+	if _, err := j.cur.Write([]byte{0x00, 0x00, 0x10, 0x00}); err != nil {
+		t.Fatalf("write torn header: %v", err)
+	}
+	if err := j.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	j2, err := Open(dir, WithFsyncPolicy(FsyncNever, time.Second))
+	if err != nil {
+		t.Fatalf("Open after torn tail: %v", err)
+	}
+	defer j2.Close()
+
+	if j2.curSize != validSize {
+		t.Fatalf("curSize after resume = %d, want %d (torn header truncated away)", j2.curSize, validSize)
+	}
+
+	// The journal must still be appendable and replayable after recovery.
+	if _, err := j2.Append(&pb.EventBatch{Events: []*pb.Event{eventAt(time.Now())}}); err != nil {
+		t.Fatalf("Append after recovery: %v", err)
+	}
+	var n int
+	if err := j2.ReplayFrom(Checkpoint{SegmentID: 1, Offset: 0}, func(*pb.EventBatch) error {
+		n++
+		return nil
+	}); err != nil {
+		t.Fatalf("ReplayFrom after recovery: %v", err)
+	}
+	if n != 2 {
+		t.Fatalf("replayed %d batches after recovery, want 2 (the original record plus the post-recovery Append)", n)
+	}
+}
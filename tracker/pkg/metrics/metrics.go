@@ -0,0 +1,49 @@
+// Package metrics holds the Tracker's Prometheus collectors. Keeping them
+// in one package (rather than scattered package-level vars in cmd/tracker)
+// gives every collector one definition to check against when adding a new
+// label or renaming a metric.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// EventsTotal counts events successfully delivered to a client, labeled by
+// client id (see server.clientReg.id).
+var EventsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tracker_events_total",
+	Help: "Events delivered to a subscribed client.",
+}, []string{"client"})
+
+// EventsDropped counts events the broadcaster could not deliver to a
+// client, labeled by client id and the reason (drop_oldest, drop_newest,
+// block_deadline_exceeded, disconnect_on_overflow - see DeliveryMode).
+var EventsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tracker_events_dropped_total",
+	Help: "Events dropped instead of delivered to a client, by reason.",
+}, []string{"client", "reason"})
+
+// RingbufLost counts events the kernel itself dropped because the eBPF
+// ring buffer was full, before they ever reached broadcastEvents. Backed
+// by the dropped_events map in tracepoints.c.
+var RingbufLost = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "tracker_ringbuf_lost_total",
+	Help: "Events dropped by the kernel because the eBPF ring buffer was full.",
+})
+
+// ClientLag is how far behind "now" the most recent batch delivered to a
+// client was by the time it was sent, labeled by client id.
+var ClientLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "tracker_client_lag_seconds",
+	Help: "Age of the most recently delivered batch's oldest event, per client.",
+}, []string{"client"})
+
+// BroadcastLatency is how long one broadcastEvents iteration takes: from
+// reading a record off the ring buffer to finishing fan-out to every
+// matching client.
+var BroadcastLatency = promauto.NewHistogram(prometheus.HistogramOpts{
+	Name:    "tracker_broadcast_latency_seconds",
+	Help:    "Time to process and fan out one ring buffer event to all matching clients.",
+	Buckets: prometheus.DefBuckets,
+})
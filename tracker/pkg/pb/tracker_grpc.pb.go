@@ -0,0 +1,238 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/tracker.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Tracker_StreamEvents_FullMethodName = "/nerrf.tracker.v1.Tracker/StreamEvents"
+	Tracker_Replay_FullMethodName       = "/nerrf.tracker.v1.Tracker/Replay"
+	Tracker_Checkpoint_FullMethodName   = "/nerrf.tracker.v1.Tracker/Checkpoint"
+)
+
+// TrackerClient is the client API for Tracker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TrackerClient interface {
+	StreamEvents(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Tracker_StreamEventsClient, error)
+	Replay(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (Tracker_ReplayClient, error)
+	Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error)
+}
+
+type trackerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTrackerClient(cc grpc.ClientConnInterface) TrackerClient {
+	return &trackerClient{cc}
+}
+
+func (c *trackerClient) StreamEvents(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Tracker_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Tracker_ServiceDesc.Streams[0], Tracker_StreamEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trackerStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tracker_StreamEventsClient interface {
+	Recv() (*EventBatch, error)
+	grpc.ClientStream
+}
+
+type trackerStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *trackerStreamEventsClient) Recv() (*EventBatch, error) {
+	m := new(EventBatch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *trackerClient) Replay(ctx context.Context, in *ReplayRequest, opts ...grpc.CallOption) (Tracker_ReplayClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Tracker_ServiceDesc.Streams[1], Tracker_Replay_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trackerReplayClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tracker_ReplayClient interface {
+	Recv() (*EventBatch, error)
+	grpc.ClientStream
+}
+
+type trackerReplayClient struct {
+	grpc.ClientStream
+}
+
+func (x *trackerReplayClient) Recv() (*EventBatch, error) {
+	m := new(EventBatch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *trackerClient) Checkpoint(ctx context.Context, in *CheckpointRequest, opts ...grpc.CallOption) (*CheckpointResponse, error) {
+	out := new(CheckpointResponse)
+	err := c.cc.Invoke(ctx, Tracker_Checkpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrackerServer is the server API for Tracker service.
+// All implementations must embed UnimplementedTrackerServer
+// for forward compatibility
+type TrackerServer interface {
+	StreamEvents(*StreamRequest, Tracker_StreamEventsServer) error
+	Replay(*ReplayRequest, Tracker_ReplayServer) error
+	Checkpoint(context.Context, *CheckpointRequest) (*CheckpointResponse, error)
+	mustEmbedUnimplementedTrackerServer()
+}
+
+// UnimplementedTrackerServer must be embedded to have forward compatible implementations.
+type UnimplementedTrackerServer struct {
+}
+
+func (UnimplementedTrackerServer) StreamEvents(*StreamRequest, Tracker_StreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedTrackerServer) Replay(*ReplayRequest, Tracker_ReplayServer) error {
+	return status.Errorf(codes.Unimplemented, "method Replay not implemented")
+}
+func (UnimplementedTrackerServer) Checkpoint(context.Context, *CheckpointRequest) (*CheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkpoint not implemented")
+}
+func (UnimplementedTrackerServer) mustEmbedUnimplementedTrackerServer() {}
+
+// UnsafeTrackerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrackerServer will
+// result in compilation errors.
+type UnsafeTrackerServer interface {
+	mustEmbedUnimplementedTrackerServer()
+}
+
+func RegisterTrackerServer(s grpc.ServiceRegistrar, srv TrackerServer) {
+	s.RegisterService(&Tracker_ServiceDesc, srv)
+}
+
+func _Tracker_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TrackerServer).StreamEvents(m, &trackerStreamEventsServer{stream})
+}
+
+type Tracker_StreamEventsServer interface {
+	Send(*EventBatch) error
+	grpc.ServerStream
+}
+
+type trackerStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *trackerStreamEventsServer) Send(m *EventBatch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Tracker_Replay_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplayRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TrackerServer).Replay(m, &trackerReplayServer{stream})
+}
+
+type Tracker_ReplayServer interface {
+	Send(*EventBatch) error
+	grpc.ServerStream
+}
+
+type trackerReplayServer struct {
+	grpc.ServerStream
+}
+
+func (x *trackerReplayServer) Send(m *EventBatch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Tracker_Checkpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackerServer).Checkpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Tracker_Checkpoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackerServer).Checkpoint(ctx, req.(*CheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Tracker_ServiceDesc is the grpc.ServiceDesc for Tracker service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Tracker_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "nerrf.tracker.v1.Tracker",
+	HandlerType: (*TrackerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Checkpoint",
+			Handler:    _Tracker_Checkpoint_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _Tracker_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Replay",
+			Handler:       _Tracker_Replay_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/tracker.proto",
+}